@@ -0,0 +1,152 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"github.com/golang/freetype/truetype"
+	"github.com/wcharczuk/go-chart/v2"
+)
+
+// Annotation pins an explanatory label to a specific data coordinate,
+// independent of series Label.Show.
+type Annotation struct {
+	// X is the x-axis value the annotation is anchored to, either a category
+	// (string) or a numeric value depending on the x-axis mode
+	X any
+	// Y is the value-axis coordinate the annotation is anchored to
+	Y float64
+	// Label is the text shown in the annotation box
+	Label string
+	// Style overrides the default annotation box/text style
+	Style Style
+	// Padding around the label text inside the annotation box
+	Padding Box
+}
+
+const annotationDefaultPadding = 4
+
+// categoryIndex returns the position of label within categories, or -1 if
+// it isn't present.
+func categoryIndex(categories []string, label string) int {
+	for i, c := range categories {
+		if c == label {
+			return i
+		}
+	}
+	return -1
+}
+
+// renderAnnotations draws a boxed text callout for each annotation at its
+// translated point, with a leader line to the anchor when the box doesn't
+// cover it. It is invoked after the mark-point/mark-line painters from
+// barChart.render; the other chart types do not call it.
+//
+// categories and categoryCenter let a string-valued Annotation.X resolve to
+// the same pixel column the bars for that category were actually drawn at:
+// categories is the x-axis category data (for the label lookup) and
+// categoryCenter maps a divide value to the horizontal center of that
+// category's whole bar group (margin and multi-series width included),
+// rather than the raw, unmargined divide value.
+func renderAnnotations(seriesPainter *Painter, theme ColorPalette, font *truetype.Font, xRange *Range, yRange *Range, categories []string, categoryCenter func(divideValue int) int, annotations []Annotation) {
+	if len(annotations) == 0 {
+		return
+	}
+	plotHeight := seriesPainter.Height()
+	for _, annotation := range annotations {
+		anchor := Point{
+			Y: plotHeight - int(yRange.getHeight(annotation.Y)),
+		}
+		switch x := annotation.X.(type) {
+		case string:
+			index := categoryIndex(categories, x)
+			divideValues := xRange.AutoDivide()
+			if index >= 0 && index < len(divideValues) {
+				anchor.X = divideValues[index]
+				if categoryCenter != nil {
+					anchor.X = categoryCenter(anchor.X)
+				}
+			}
+		case float64:
+			anchor.X = int(xRange.getHeight(x))
+		case int:
+			anchor.X = int(xRange.getHeight(float64(x)))
+		}
+
+		style := annotation.Style
+		if style.FontColor.IsZero() {
+			style.FontColor = theme.GetTextColor()
+		}
+		if style.FontSize == 0 {
+			style.FontSize = labelFontSize
+		}
+		if style.Font == nil {
+			style.Font = font
+		}
+		if style.FillColor.IsZero() {
+			style.FillColor = theme.GetBackgroundColor()
+		}
+		if style.StrokeColor.IsZero() {
+			style.StrokeColor = theme.GetAxisStrokeColor()
+		}
+
+		padding := annotation.Padding
+		if padding.IsZero() {
+			padding = Box{
+				Left:   annotationDefaultPadding,
+				Top:    annotationDefaultPadding,
+				Right:  annotationDefaultPadding,
+				Bottom: annotationDefaultPadding,
+			}
+		}
+
+		textBox := seriesPainter.OverrideTextStyle(style).MeasureText(annotation.Label)
+		boxWidth := textBox.Width() + padding.Left + padding.Right
+		boxHeight := textBox.Height() + padding.Top + padding.Bottom
+
+		// 默认将标注框放置在锚点正上方
+		boxLeft := anchor.X - boxWidth>>1
+		boxTop := anchor.Y - boxHeight - annotationDefaultPadding
+		boxRight := boxLeft + boxWidth
+		boxBottom := boxTop + boxHeight
+
+		// The box always sits annotationDefaultPadding above the anchor (see
+		// boxTop above), so it never covers the anchor point; the leader
+		// line connecting them is always needed, not conditional.
+		leaderStyle := Style{
+			StrokeColor: style.StrokeColor,
+			StrokeWidth: 1,
+		}
+		seriesPainter.OverrideDrawingStyle(leaderStyle).LineStroke([]Point{
+			anchor,
+			{X: (boxLeft + boxRight) >> 1, Y: boxBottom},
+		}, LineStyle{StrokeColor: style.StrokeColor, StrokeWidth: 1})
+
+		seriesPainter.OverrideDrawingStyle(style).Rect(chart.Box{
+			Left:   boxLeft,
+			Top:    boxTop,
+			Right:  boxRight,
+			Bottom: boxBottom,
+		})
+		seriesPainter.OverrideTextStyle(style).Text(annotation.Label, boxLeft+padding.Left, boxBottom-padding.Bottom)
+	}
+}
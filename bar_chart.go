@@ -27,6 +27,13 @@ import (
 	"github.com/wcharczuk/go-chart/v2"
 )
 
+// defaultValueAxisDivideCount is the tick count used when re-deriving a
+// value axis range outside of defaultRender (which picks its own divide
+// count from the axis options). NewRange divides Max-Min by DivideCount to
+// get its tick unit, so leaving DivideCount at the zero value collapses
+// every range to {Min, Min} and every getHeight() call to 0.
+const defaultValueAxisDivideCount = 6
+
 type barChart struct {
 	p   *Painter
 	opt *BarChartOption
@@ -60,6 +67,18 @@ type BarChartOption struct {
 	Title TitleOption
 	// The legend option
 	Legend LegendOption
+	// Stack draws each series on top of the cumulative height of the
+	// previous series for the same x-index, rather than side by side.
+	Stack bool
+	// Percent normalizes each stacked column to 100%, it only takes
+	// effect when Stack is true.
+	Percent bool
+	// Horizontal swaps the x/y roles so categories run down the y-axis
+	// and value bars extend rightward.
+	Horizontal bool
+	// Annotations pins explanatory labels to specific data coordinates,
+	// independent of series Label.Show.
+	Annotations []Annotation
 }
 
 type barChartLabelRenderOption struct {
@@ -74,9 +93,16 @@ func (b *barChart) render(result *defaultRenderResult, seriesList SeriesList) (B
 	opt := b.opt
 	seriesPainter := result.seriesPainter
 
+	// 横向的bar chart，分类轴与数值轴互换
+	catSize := seriesPainter.Width()
+	barMaxHeight := seriesPainter.Height()
+	if opt.Horizontal {
+		catSize = seriesPainter.Height()
+		barMaxHeight = seriesPainter.Width()
+	}
 	xRange := NewRange(AxisRangeOption{
 		DivideCount: len(opt.XAxis.Data),
-		Size:        seriesPainter.Width(),
+		Size:        catSize,
 	})
 	x0, x1 := xRange.GetRange(0)
 	width := int(x1 - x0)
@@ -92,11 +118,27 @@ func (b *barChart) render(result *defaultRenderResult, seriesList SeriesList) (B
 		barMargin = 3
 	}
 	seriesCount := len(seriesList)
-	// 总的宽度-两个margin-(总数-1)的barMargin
-	barWidth := (width - 2*margin - barMargin*(seriesCount-1)) / len(seriesList)
-	barMaxHeight := seriesPainter.Height()
+	// stack模式下，每一个分类只绘制一个bar，柱宽占满分组宽度
+	barWidth := width - 2*margin
+	if !opt.Stack {
+		// 总的宽度-两个margin-(总数-1)的barMargin
+		barWidth = (width - 2*margin - barMargin*(seriesCount-1)) / len(seriesList)
+	}
 	theme := opt.Theme
 	seriesNames := seriesList.Names()
+	// stack及percent-stack模式下，记录每一个分类当前已经堆叠的高度及累计值
+	stackedHeights := make([]int, xRange.divideCount)
+	columnTotals := make([]float64, xRange.divideCount)
+	if opt.Stack && opt.Percent {
+		for _, series := range seriesList {
+			for j, item := range series.Data {
+				if j >= xRange.divideCount {
+					continue
+				}
+				columnTotals[j] += item.Value
+			}
+		}
+	}
 
 	markPointPainter := NewMarkPointPainter(seriesPainter)
 	markLinePainter := NewMarkLinePainter(seriesPainter)
@@ -118,36 +160,56 @@ func (b *barChart) render(result *defaultRenderResult, seriesList SeriesList) (B
 			}
 			x := divideValues[j]
 			x += margin
-			if index != 0 {
+			if !opt.Stack && index != 0 {
 				x += index * (barWidth + barMargin)
 			}
 
-			h := int(yRange.getHeight(item.Value))
+			value := item.Value
+			if opt.Stack && opt.Percent && columnTotals[j] != 0 {
+				value = item.Value / columnTotals[j] * 100
+			}
+			h := int(yRange.getHeight(value))
 			fillColor := seriesColor
 			if !item.Style.FillColor.IsZero() {
 				fillColor = item.Style.FillColor
 			}
 			top := barMaxHeight - h
+			if opt.Stack {
+				top = barMaxHeight - stackedHeights[j] - h
+				stackedHeights[j] += h
+			}
 
-			seriesPainter.OverrideDrawingStyle(Style{
-				FillColor: fillColor,
-			}).Rect(chart.Box{
+			rectBox := chart.Box{
 				Top:    top,
 				Left:   x,
 				Right:  x + barWidth,
 				Bottom: barMaxHeight - 1,
-			})
-			// 用于生成marker point
-			points[j] = Point{
-				// 居中的位置
-				X: x + barWidth>>1,
-				Y: top,
 			}
-			// 用于生成marker point
+			if opt.Stack {
+				// stack模式下，每一段只覆盖自身的高度，不遮挡下方已绘制的段
+				rectBox.Bottom = top + h - 1
+			}
+			if opt.Horizontal {
+				// 横向模式下，分类沿y轴排列，数值条向右延伸
+				rectBox = chart.Box{
+					Top:    x,
+					Left:   0,
+					Right:  h,
+					Bottom: x + barWidth,
+				}
+				if opt.Stack {
+					rectBox.Left = stackedHeights[j] - h
+					rectBox.Right = stackedHeights[j]
+				}
+			}
+
+			seriesPainter.OverrideDrawingStyle(Style{
+				FillColor: fillColor,
+			}).Rect(rectBox)
+			// 用于生成marker point，取矩形中点
 			points[j] = Point{
-				// 居中的位置
-				X: x + barWidth>>1,
-				Y: top,
+				X: (rectBox.Left + rectBox.Right) >> 1,
+				Y: (rectBox.Top + rectBox.Bottom) >> 1,
 			}
 			// 如果label不需要展示，则返回
 			if !series.Label.Show {
@@ -169,11 +231,17 @@ func (b *barChart) render(result *defaultRenderResult, seriesList SeriesList) (B
 
 			textBox := seriesPainter.MeasureText(text)
 
+			labelX := x + (barWidth-textBox.Width())>>1
+			labelY := top - distance
+			if opt.Horizontal {
+				labelX = rectBox.Right + distance
+				labelY = (rectBox.Top+rectBox.Bottom)>>1 + textBox.Height()>>1
+			}
 			labelRenderOptions = append(labelRenderOptions, barChartLabelRenderOption{
 				Text:  text,
 				Style: labelStyle,
-				X:     x + (barWidth-textBox.Width())>>1,
-				Y:     barMaxHeight - h - distance,
+				X:     labelX,
+				Y:     labelY,
 			})
 		}
 
@@ -202,6 +270,13 @@ func (b *barChart) render(result *defaultRenderResult, seriesList SeriesList) (B
 		return BoxZero, err
 	}
 
+	// 标注锚点应落在整个分类的柱形分组中央（margin+该分类下所有bar的总宽度），
+	// 而非未加margin的原始分割值
+	categoryWidth := width - 2*margin
+	renderAnnotations(seriesPainter, theme, opt.Font, xRange, result.axisRanges[0], opt.XAxis.Data, func(divideValue int) int {
+		return divideValue + margin + categoryWidth/2
+	}, opt.Annotations)
+
 	return p.box, nil
 }
 
@@ -216,10 +291,59 @@ func (b *barChart) Render() (Box, error) {
 		YAxisOptions: opt.YAxisOptions,
 		TitleOption:  opt.Title,
 		LegendOption: opt.Legend,
+		// 横向模式下，分类轴与数值轴互换，轴的刻度/文字布局也需要随之翻转
+		Horizontal: opt.Horizontal,
 	})
 	if err != nil {
 		return BoxZero, err
 	}
 	seriesList := opt.SeriesList.Filter(ChartTypeLine)
+	if opt.Stack {
+		rebuildStackedAxisRanges(renderResult, seriesList, opt)
+	}
 	return b.render(renderResult, seriesList)
 }
+
+// rebuildStackedAxisRanges re-derives each y-axis range from the cumulative
+// column totals of the series assigned to it. Stack/Percent mode draws
+// values on top of each other rather than independently, so the axis built
+// by defaultRender from each series' own per-item min/max would otherwise
+// clip the stacked bars (or, in Percent mode, be scaled against the
+// original raw values instead of the 0-100 normalized ones).
+func rebuildStackedAxisRanges(result *defaultRenderResult, seriesList SeriesList, opt *BarChartOption) {
+	size := result.seriesPainter.Height()
+	if opt.Horizontal {
+		size = result.seriesPainter.Width()
+	}
+	totalsByAxis := make(map[int][]float64)
+	for _, series := range seriesList {
+		totals := totalsByAxis[series.AxisIndex]
+		for j, item := range series.Data {
+			for len(totals) <= j {
+				totals = append(totals, 0)
+			}
+			totals[j] += item.Value
+		}
+		totalsByAxis[series.AxisIndex] = totals
+	}
+	for axisIndex, totals := range totalsByAxis {
+		if axisIndex >= len(result.axisRanges) {
+			continue
+		}
+		max := 0.0
+		for _, total := range totals {
+			if total > max {
+				max = total
+			}
+		}
+		if opt.Percent {
+			max = 100
+		}
+		result.axisRanges[axisIndex] = NewRange(AxisRangeOption{
+			Min:         0,
+			Max:         max,
+			Size:        size,
+			DivideCount: defaultValueAxisDivideCount,
+		})
+	}
+}
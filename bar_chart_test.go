@@ -0,0 +1,57 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import "testing"
+
+// A stacked/percent axis range rebuilt without a DivideCount collapses to
+// {Min, Min} (NewRange's unit computation divides by DivideCount), so every
+// getHeight() call returns 0 and every bar in Stack/Percent mode renders
+// with height 0. These tests check NewRange with defaultValueAxisDivideCount,
+// the DivideCount that rebuildStackedAxisRanges passes through.
+func TestDefaultValueAxisDivideCountRangeNotCollapsed(t *testing.T) {
+	r := NewRange(AxisRangeOption{
+		Min:         0,
+		Max:         200,
+		Size:        400,
+		DivideCount: defaultValueAxisDivideCount,
+	})
+
+	if h := r.getHeight(100); h == 0 {
+		t.Fatalf("getHeight(100) = 0 with Max=200, Min=0; the range collapsed to {Min, Min} (missing DivideCount)")
+	}
+}
+
+func TestDefaultValueAxisDivideCountRangeShape(t *testing.T) {
+	size := 400
+	max := 200.0
+	withoutDivideCount := NewRange(AxisRangeOption{Min: 0, Max: max, Size: size})
+	withDivideCount := NewRange(AxisRangeOption{Min: 0, Max: max, Size: size, DivideCount: defaultValueAxisDivideCount})
+
+	if withoutDivideCount.getHeight(max/2) == withDivideCount.getHeight(max/2) {
+		t.Fatalf("expected the zero-DivideCount range to behave differently (degenerately) than one with DivideCount set")
+	}
+	if got := withDivideCount.getHeight(max); got != float64(size) {
+		t.Errorf("getHeight(Max) = %v, want Size (%v)", got, size)
+	}
+}
@@ -0,0 +1,233 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"reflect"
+
+	"github.com/wcharczuk/go-chart/v2"
+)
+
+type bufferOpKind int
+
+const (
+	bufferOpMoveTo bufferOpKind = iota
+	bufferOpLineTo
+	bufferOpCircle
+	bufferOpText
+	bufferOpStroke
+	bufferOpFill
+	bufferOpFillStroke
+)
+
+// bufferOp is a single recorded drawing primitive, in absolute pixel
+// coordinates, along with the style active when it was recorded.
+type bufferOp struct {
+	kind   bufferOpKind
+	x, y   int
+	radius float64
+	text   string
+	style  chart.Style
+}
+
+// box returns the sub-box this op touches, used to compute dirty regions.
+func (o bufferOp) box() chart.Box {
+	switch o.kind {
+	case bufferOpCircle:
+		r := int(o.radius)
+		return chart.Box{Left: o.x - r, Top: o.y - r, Right: o.x + r, Bottom: o.y + r}
+	default:
+		return chart.Box{Left: o.x, Top: o.y, Right: o.x, Bottom: o.y}
+	}
+}
+
+// Buffer is an intermediate display list of styled drawing primitives that
+// Draw accumulates before flushing them to the underlying chart.Renderer.
+// Ops are replayed in the order they were recorded; the buffer does no
+// clipping or reordering of its own. Buffering (rather than emitting renderer
+// calls immediately) lets a caller re-flush only the sub-boxes that changed
+// for animated or streaming use cases.
+type Buffer struct {
+	ops   []bufferOp
+	style chart.Style
+}
+
+// NewBuffer returns an empty display list.
+func NewBuffer() *Buffer {
+	return &Buffer{}
+}
+
+func (b *Buffer) moveTo(x, y int) {
+	b.ops = append(b.ops, bufferOp{kind: bufferOpMoveTo, x: x, y: y, style: b.style})
+}
+
+func (b *Buffer) lineTo(x, y int) {
+	b.ops = append(b.ops, bufferOp{kind: bufferOpLineTo, x: x, y: y, style: b.style})
+}
+
+func (b *Buffer) circle(radius float64, x, y int) {
+	b.ops = append(b.ops, bufferOp{kind: bufferOpCircle, x: x, y: y, radius: radius, style: b.style})
+}
+
+func (b *Buffer) text(body string, x, y int) {
+	b.ops = append(b.ops, bufferOp{kind: bufferOpText, x: x, y: y, text: body, style: b.style})
+}
+
+func (b *Buffer) setStyle(s chart.Style) {
+	b.style = s
+}
+
+func (b *Buffer) stroke() {
+	b.ops = append(b.ops, bufferOp{kind: bufferOpStroke, style: b.style})
+}
+
+func (b *Buffer) fill() {
+	b.ops = append(b.ops, bufferOp{kind: bufferOpFill, style: b.style})
+}
+
+func (b *Buffer) fillStroke() {
+	b.ops = append(b.ops, bufferOp{kind: bufferOpFillStroke, style: b.style})
+}
+
+// Box returns the bounding box of every primitive recorded in the buffer.
+func (b *Buffer) Box() chart.Box {
+	if len(b.ops) == 0 {
+		return chart.BoxZero
+	}
+	box := b.ops[0].box()
+	for _, op := range b.ops[1:] {
+		box = chart.Box{
+			Left:   minInt(box.Left, op.box().Left),
+			Top:    minInt(box.Top, op.box().Top),
+			Right:  maxInt(box.Right, op.box().Right),
+			Bottom: maxInt(box.Bottom, op.box().Bottom),
+		}
+	}
+	return box
+}
+
+// Flush replays every recorded op against r.
+func (b *Buffer) Flush(r chart.Renderer) {
+	b.flushRange(r, 0, len(b.ops))
+}
+
+// FlushDirty replays only the ops whose box intersects one of the given
+// dirty sub-boxes, so partial re-draws (e.g. a single changed series in an
+// animated chart) avoid re-rasterizing the whole canvas.
+func (b *Buffer) FlushDirty(r chart.Renderer, dirty []chart.Box) {
+	var lastStyle chart.Style
+	for i, op := range b.ops {
+		if !intersectsAny(op.box(), dirty) {
+			continue
+		}
+		if i == 0 || !reflect.DeepEqual(op.style, lastStyle) {
+			op.style.WriteToRenderer(r)
+			lastStyle = op.style
+		}
+		applyOp(r, op)
+	}
+}
+
+func (b *Buffer) flushRange(r chart.Renderer, from, to int) {
+	var lastStyle chart.Style
+	for i := from; i < to; i++ {
+		op := b.ops[i]
+		if i == from || !reflect.DeepEqual(op.style, lastStyle) {
+			op.style.WriteToRenderer(r)
+			lastStyle = op.style
+		}
+		applyOp(r, op)
+	}
+}
+
+func applyOp(r chart.Renderer, op bufferOp) {
+	switch op.kind {
+	case bufferOpMoveTo:
+		r.MoveTo(op.x, op.y)
+	case bufferOpLineTo:
+		r.LineTo(op.x, op.y)
+	case bufferOpCircle:
+		r.Circle(op.radius, op.x, op.y)
+	case bufferOpText:
+		r.Text(op.text, op.x, op.y)
+	case bufferOpStroke:
+		r.Stroke()
+	case bufferOpFill:
+		r.Fill()
+	case bufferOpFillStroke:
+		r.FillStroke()
+	}
+}
+
+func intersectsAny(box chart.Box, boxes []chart.Box) bool {
+	for _, d := range boxes {
+		if box.Left <= d.Right && box.Right >= d.Left &&
+			box.Top <= d.Bottom && box.Bottom >= d.Top {
+			return true
+		}
+	}
+	return false
+}
+
+// DiffBuffers compares two buffers recorded for the same canvas and returns
+// the bounding boxes of the ops that differ, so an incremental export can
+// flush only the changed regions instead of the whole buffer.
+func DiffBuffers(prev, cur *Buffer) []chart.Box {
+	var dirty []chart.Box
+	max := len(prev.ops)
+	if len(cur.ops) > max {
+		max = len(cur.ops)
+	}
+	for i := 0; i < max; i++ {
+		var prevOp, curOp bufferOp
+		if i < len(prev.ops) {
+			prevOp = prev.ops[i]
+		}
+		if i < len(cur.ops) {
+			curOp = cur.ops[i]
+		}
+		if !reflect.DeepEqual(prevOp, curOp) {
+			if i < len(prev.ops) {
+				dirty = append(dirty, prevOp.box())
+			}
+			if i < len(cur.ops) {
+				dirty = append(dirty, curOp.box())
+			}
+		}
+	}
+	return dirty
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
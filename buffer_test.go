@@ -0,0 +1,60 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2"
+)
+
+func TestBufferBoxEmpty(t *testing.T) {
+	b := NewBuffer()
+	box := b.Box()
+	if box != chart.BoxZero {
+		t.Fatalf("expected BoxZero for an empty buffer, got %+v", box)
+	}
+}
+
+func TestBufferBoxSeedsFromFirstOp(t *testing.T) {
+	b := NewBuffer()
+	b.moveTo(200, 300)
+	b.lineTo(400, 350)
+
+	box := b.Box()
+	want := chart.Box{Left: 200, Top: 300, Right: 400, Bottom: 350}
+	if box != want {
+		t.Fatalf("Box() = %+v, want %+v (must not include the origin when no op touches it)", box, want)
+	}
+}
+
+func TestBufferBoxIncludesCircleRadius(t *testing.T) {
+	b := NewBuffer()
+	b.circle(10, 200, 200)
+
+	box := b.Box()
+	want := chart.Box{Left: 190, Top: 190, Right: 210, Bottom: 210}
+	if box != want {
+		t.Fatalf("Box() = %+v, want %+v", box, want)
+	}
+}
@@ -38,11 +38,19 @@ const (
 	PositionBottom = "bottom"
 )
 
+// TypePDF selects the vector PDF backend in DrawOption.Type, alongside the
+// "svg" and "png" types supported natively by chart.SVG/chart.PNG.
+const TypePDF = "pdf"
+
 type Draw struct {
 	Render chart.Renderer
 	Box    chart.Box
 	Font   *truetype.Font
 	parent *Draw
+	// buffer accumulates drawing primitives instead of emitting them to
+	// Render immediately; it is shared with parent/child Draws the same
+	// way Render is, and flushed to Render in Bytes().
+	buffer *Buffer
 }
 
 type DrawOption struct {
@@ -77,6 +85,7 @@ func NewDraw(opt DrawOption, opts ...Option) (*Draw, error) {
 	if opt.Parent != nil {
 		d.parent = opt.Parent
 		d.Render = d.parent.Render
+		d.buffer = d.parent.buffer
 		d.Box = opt.Parent.Box.Clone()
 	}
 	if width != 0 && height != 0 {
@@ -85,15 +94,26 @@ func NewDraw(opt DrawOption, opts ...Option) (*Draw, error) {
 	}
 	// 创建render
 	if d.parent == nil {
-		fn := chart.SVG
-		if opt.Type == "png" {
-			fn = chart.PNG
-		}
-		r, err := fn(d.Box.Right, d.Box.Bottom)
-		if err != nil {
-			return nil, err
+		if opt.Type == TypePDF {
+			r, err := newPDFRenderer(d.Box.Right, d.Box.Bottom)
+			if err != nil {
+				return nil, err
+			}
+			d.Render = r
+		} else {
+			fn := chart.SVG
+			if opt.Type == "png" {
+				fn = chart.PNG
+			}
+			r, err := fn(d.Box.Right, d.Box.Bottom)
+			if err != nil {
+				return nil, err
+			}
+			d.Render = r
 		}
-		d.Render = r
+	}
+	if d.buffer == nil {
+		d.buffer = NewBuffer()
 	}
 
 	for _, o := range opts {
@@ -124,29 +144,49 @@ func (d *Draw) Top() *Draw {
 	return t
 }
 
+// Bytes flushes every primitive recorded in the buffer to the underlying
+// Render, in the order they were recorded, and serializes it. Nested Draws
+// share the buffer, so their sub-draws are neither clipped nor reordered.
 func (d *Draw) Bytes() ([]byte, error) {
-	buffer := bytes.Buffer{}
-	err := d.Render.Save(&buffer)
+	d.buffer.Flush(d.Render)
+	buf := bytes.Buffer{}
+	err := d.Render.Save(&buf)
 	if err != nil {
 		return nil, err
 	}
-	return buffer.Bytes(), err
+	return buf.Bytes(), err
+}
+
+// FlushDirty flushes only the buffered ops intersecting the given sub-boxes
+// (in this Draw's local coordinate space), for partial re-draws of an
+// animated or streaming chart.
+func (d *Draw) FlushDirty(dirty []chart.Box) {
+	translated := make([]chart.Box, len(dirty))
+	for i, box := range dirty {
+		translated[i] = chart.Box{
+			Left:   box.Left + d.Box.Left,
+			Top:    box.Top + d.Box.Top,
+			Right:  box.Right + d.Box.Left,
+			Bottom: box.Bottom + d.Box.Top,
+		}
+	}
+	d.buffer.FlushDirty(d.Render, translated)
 }
 
 func (d *Draw) moveTo(x, y int) {
-	d.Render.MoveTo(x+d.Box.Left, y+d.Box.Top)
+	d.buffer.moveTo(x+d.Box.Left, y+d.Box.Top)
 }
 
 func (d *Draw) lineTo(x, y int) {
-	d.Render.LineTo(x+d.Box.Left, y+d.Box.Top)
+	d.buffer.lineTo(x+d.Box.Left, y+d.Box.Top)
 }
 
 func (d *Draw) circle(radius float64, x, y int) {
-	d.Render.Circle(radius, x+d.Box.Left, y+d.Box.Top)
+	d.buffer.circle(radius, x+d.Box.Left, y+d.Box.Top)
 }
 
 func (d *Draw) text(body string, x, y int) {
-	d.Render.Text(body, x+d.Box.Left, y+d.Box.Top)
+	d.buffer.text(body, x+d.Box.Left, y+d.Box.Top)
 }
 
 func (d *Draw) lineStroke(points []Point, style LineStyle) {
@@ -154,8 +194,7 @@ func (d *Draw) lineStroke(points []Point, style LineStyle) {
 	if !s.ShouldDrawStroke() {
 		return
 	}
-	r := d.Render
-	s.GetStrokeOptions().WriteDrawingOptionsToRenderer(r)
+	d.buffer.setStyle(s.GetStrokeOptions())
 	for index, point := range points {
 		x := point.X
 		y := point.Y
@@ -165,19 +204,17 @@ func (d *Draw) lineStroke(points []Point, style LineStyle) {
 			d.lineTo(x, y)
 		}
 	}
-	r.Stroke()
+	d.buffer.stroke()
 }
 
 func (d *Draw) setBackground(width, height int, color drawing.Color) {
-	r := d.Render
-	s := chart.Style{
+	d.buffer.setStyle(chart.Style{
 		FillColor: color,
-	}
-	s.WriteToRenderer(r)
-	r.MoveTo(0, 0)
-	r.LineTo(width, 0)
-	r.LineTo(width, height)
-	r.LineTo(0, height)
-	r.LineTo(0, 0)
-	r.FillStroke()
+	})
+	d.moveTo(0, 0)
+	d.lineTo(width, 0)
+	d.lineTo(width, height)
+	d.lineTo(0, height)
+	d.lineTo(0, 0)
+	d.buffer.fillStroke()
 }
@@ -0,0 +1,205 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"errors"
+
+	"github.com/wcharczuk/go-chart/v2"
+)
+
+// GridCell builds the Renderer that occupies a Grid cell, given the
+// sub-Draw the Grid has computed for it. Pass (*Grid).Bind to nest another
+// Grid as a cell.
+type GridCell func(d *Draw) (Renderer, error)
+
+// GridColumn pairs a GridCell with its width ratio (relative to the sum of
+// all column ratios in its row), the column equivalent of AddRow's row
+// ratio.
+type GridColumn struct {
+	Ratio float64
+	Cell  GridCell
+}
+
+type gridRow struct {
+	ratio   float64
+	columns []GridColumn
+}
+
+// GridOption configures a Grid's cell padding and title strip.
+type GridOption struct {
+	// Padding is applied inside every cell's Box before its renderer is built
+	Padding Box
+	// Title, when set, reserves a strip at the top of the grid for a heading
+	Title string
+}
+
+const gridTitleHeight = 24
+
+// Grid lays out charts (or nested Grids) in rows and columns with
+// fractional height/width weights, the way the termui rewrite's ratio grid
+// replaced its fixed 12-column one. It builds on Draw/DrawOption.Parent: each
+// cell gets its own child Draw sized from the ratio tree, and renders into
+// the same shared buffer as the rest of the chart.
+type Grid struct {
+	draw    *Draw
+	padding Box
+	title   string
+	rows    []gridRow
+}
+
+// NewGrid returns a Grid that lays out cells within draw's Box.
+func NewGrid(draw *Draw, opt ...GridOption) *Grid {
+	g := &Grid{
+		draw: draw,
+	}
+	if len(opt) > 0 {
+		g.padding = opt[0].Padding
+		g.title = opt[0].Title
+	}
+	return g
+}
+
+// AddRow adds a row with the given height ratio (relative to the sum of all
+// row ratios), split evenly among cells left to right.
+func (g *Grid) AddRow(ratio float64, cells ...GridCell) {
+	columns := make([]GridColumn, len(cells))
+	for i, cell := range cells {
+		columns[i] = GridColumn{Ratio: 1, Cell: cell}
+	}
+	g.rows = append(g.rows, gridRow{
+		ratio:   ratio,
+		columns: columns,
+	})
+}
+
+// AddWeightedRow adds a row with the given height ratio, laying out columns
+// left to right using each column's own width ratio (relative to the sum of
+// all column ratios in this row), the column equivalent of ratio.
+func (g *Grid) AddWeightedRow(ratio float64, columns ...GridColumn) {
+	g.rows = append(g.rows, gridRow{
+		ratio:   ratio,
+		columns: columns,
+	})
+}
+
+// Bind satisfies GridCell, letting one Grid be nested inside another:
+//
+//	parent.AddRow(0.5, nested.Bind)
+func (g *Grid) Bind(d *Draw) (Renderer, error) {
+	g.draw = d
+	return g, nil
+}
+
+// Render computes each cell's Box from the parent Box and the ratio tree,
+// and renders every leaf into it. It returns the combined Box covering the
+// whole grid; the caller flushes the shared Draw/buffer with Bytes() as
+// usual to get the final SVG/PNG/PDF output.
+func (g *Grid) Render() (Box, error) {
+	if g.draw == nil {
+		return BoxZero, errors.New("charts: grid has no draw")
+	}
+	box := g.draw.Box.Clone()
+	top := box.Top
+	if g.title != "" {
+		g.draw.buffer.setStyle(chart.Style{
+			FontColor: defaultTheme.GetTextColor(),
+			FontSize:  labelFontSize,
+			Font:      g.draw.Font,
+		})
+		// text() adds d.Box.Left/Top itself, so the position here is local
+		g.draw.text(g.title, 0, gridTitleHeight-6)
+		top += gridTitleHeight
+	}
+
+	totalRatio := 0.0
+	for _, row := range g.rows {
+		if row.ratio < 0 {
+			return BoxZero, errors.New("charts: grid row ratio must not be negative")
+		}
+		totalRatio += row.ratio
+	}
+	if totalRatio <= 0 {
+		return BoxZero, errors.New("charts: grid rows must have a positive ratio")
+	}
+
+	availableHeight := box.Bottom - top
+	usedRatio := 0.0
+	rowTop := top
+	for _, row := range g.rows {
+		usedRatio += row.ratio
+		// 按累计比例计算行底部，避免逐行取整导致底部出现空隙
+		rowBottom := top + int(float64(availableHeight)*usedRatio/totalRatio)
+		rowHeight := rowBottom - rowTop
+		if len(row.columns) == 0 {
+			rowTop = rowBottom
+			continue
+		}
+		totalColRatio := 0.0
+		for _, column := range row.columns {
+			if column.Ratio < 0 {
+				return BoxZero, errors.New("charts: grid column ratio must not be negative")
+			}
+			totalColRatio += column.Ratio
+		}
+		if totalColRatio <= 0 {
+			return BoxZero, errors.New("charts: grid row columns must have a positive ratio")
+		}
+		availableWidth := box.Right - box.Left
+		usedColRatio := 0.0
+		colLeft := box.Left
+		for _, column := range row.columns {
+			usedColRatio += column.Ratio
+			// 按累计比例计算列右边界，避免逐列取整导致右侧出现空隙
+			colRight := box.Left + int(float64(availableWidth)*usedColRatio/totalColRatio)
+			cellDraw, err := g.newCellDraw(colLeft, rowTop, colRight-colLeft, rowHeight)
+			if err != nil {
+				return BoxZero, err
+			}
+			renderer, err := column.Cell(cellDraw)
+			if err != nil {
+				return BoxZero, err
+			}
+			if _, err := renderer.Render(); err != nil {
+				return BoxZero, err
+			}
+			colLeft = colRight
+		}
+		rowTop = rowBottom
+	}
+	return box, nil
+}
+
+// newCellDraw creates the child Draw for a single cell, wiring Parent through
+// NewDraw the same way every other nested Draw in this package does.
+func (g *Grid) newCellDraw(left, top, width, height int) (*Draw, error) {
+	origBox := g.draw.Box
+	g.draw.Box = chart.Box{Left: left, Top: top, Right: left, Bottom: top}
+	cellDraw, err := NewDraw(DrawOption{
+		Parent: g.draw,
+		Width:  width,
+		Height: height,
+	}, PaddingOption(g.padding))
+	g.draw.Box = origBox
+	return cellDraw, err
+}
@@ -0,0 +1,86 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import "testing"
+
+// recordingCell is a GridCell that records the width of the Draw it was
+// handed, so tests can assert on the ratio math in Grid.Render without
+// needing a real chart behind each cell.
+type recordingCell struct {
+	width int
+}
+
+func (c *recordingCell) cell(d *Draw) (Renderer, error) {
+	c.width = d.Box.Right - d.Box.Left
+	return c, nil
+}
+
+func (c *recordingCell) Render() (Box, error) {
+	return BoxZero, nil
+}
+
+func TestGridAddWeightedRowSplitsColumnsByRatio(t *testing.T) {
+	draw, err := NewDraw(DrawOption{Width: 300, Height: 100})
+	if err != nil {
+		t.Fatalf("NewDraw: %v", err)
+	}
+	g := NewGrid(draw)
+	a := &recordingCell{}
+	b := &recordingCell{}
+	g.AddWeightedRow(1,
+		GridColumn{Ratio: 1, Cell: a.cell},
+		GridColumn{Ratio: 2, Cell: b.cell},
+	)
+
+	if _, err := g.Render(); err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	if a.width != 100 {
+		t.Errorf("column with ratio 1 of 3 got width %d, want 100 (of 300)", a.width)
+	}
+	if b.width != 200 {
+		t.Errorf("column with ratio 2 of 3 got width %d, want 200 (of 300)", b.width)
+	}
+}
+
+func TestGridAddRowSplitsColumnsEvenly(t *testing.T) {
+	draw, err := NewDraw(DrawOption{Width: 300, Height: 100})
+	if err != nil {
+		t.Fatalf("NewDraw: %v", err)
+	}
+	g := NewGrid(draw)
+	a := &recordingCell{}
+	b := &recordingCell{}
+	c := &recordingCell{}
+	g.AddRow(1, a.cell, b.cell, c.cell)
+
+	if _, err := g.Render(); err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	if a.width != 100 || b.width != 100 || c.width != 100 {
+		t.Errorf("AddRow columns = (%d, %d, %d), want (100, 100, 100)", a.width, b.width, c.width)
+	}
+}
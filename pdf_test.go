@@ -0,0 +1,81 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/wcharczuk/go-chart/v2"
+	"github.com/wcharczuk/go-chart/v2/drawing"
+)
+
+func TestPDFRendererCirclePaintsFillAndStroke(t *testing.T) {
+	r, err := newPDFRenderer(400, 400)
+	if err != nil {
+		t.Fatalf("newPDFRenderer: %v", err)
+	}
+	r.SetFillColor(drawing.Color{R: 255, A: 255})
+	r.SetStrokeColor(drawing.Color{B: 255, A: 255})
+	r.SetStrokeWidth(2)
+	r.style = chart.Style{
+		FillColor:   drawing.Color{R: 255, A: 255},
+		StrokeColor: drawing.Color{B: 255, A: 255},
+		StrokeWidth: 2,
+	}
+
+	r.Circle(10, 100, 100)
+
+	out := r.ops.String()
+	if !strings.Contains(out, "B\n") {
+		t.Errorf("Circle() with fill+stroke style didn't emit a B paint operator, ops:\n%s", out)
+	}
+}
+
+func TestPDFRendererCircleFillOnly(t *testing.T) {
+	r, err := newPDFRenderer(400, 400)
+	if err != nil {
+		t.Fatalf("newPDFRenderer: %v", err)
+	}
+	r.style = chart.Style{
+		FillColor: drawing.Color{R: 255, A: 255},
+	}
+
+	r.Circle(10, 100, 100)
+
+	out := r.ops.String()
+	if !strings.Contains(out, "f\n") {
+		t.Errorf("Circle() with a fill-only style didn't emit an f paint operator, ops:\n%s", out)
+	}
+	if strings.Contains(out, "S\n") {
+		t.Errorf("Circle() with a fill-only style shouldn't emit a stroke operator, ops:\n%s", out)
+	}
+}
+
+func TestWriteStrokeOpsDefaultsWidth(t *testing.T) {
+	var buf strings.Builder
+	writeStrokeOps(&buf, chart.Style{StrokeColor: drawing.Color{G: 255, A: 255}})
+	if !strings.Contains(buf.String(), "1.00 w") {
+		t.Errorf("writeStrokeOps with StrokeWidth=0 didn't default to width 1, got: %s", buf.String())
+	}
+}
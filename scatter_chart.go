@@ -0,0 +1,330 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"math"
+
+	"github.com/golang/freetype/truetype"
+	"github.com/wcharczuk/go-chart/v2"
+)
+
+// ChartTypeScatter identifies a scatter series, the same way ChartTypeLine
+// identifies a line series, so SeriesList.Filter(ChartTypeScatter) picks out
+// only the series meant for NewScatterChart.
+const ChartTypeScatter = "scatter"
+
+// MarkerShape is the shape used to draw a scatter series point.
+type MarkerShape string
+
+const (
+	MarkerShapeCircle   MarkerShape = "circle"
+	MarkerShapeSquare   MarkerShape = "square"
+	MarkerShapeTriangle MarkerShape = "triangle"
+	MarkerShapeCross    MarkerShape = "cross"
+)
+
+// ScatterSeriesStyle controls how a single series' points are drawn.
+type ScatterSeriesStyle struct {
+	// Shape of the marker, defaults to MarkerShapeCircle
+	Shape MarkerShape
+	// Size is the marker radius in pixels, used when the data point has no third dimension
+	Size float64
+	// Opacity of the marker fill, 0-1, defaults to 1
+	Opacity float64
+	// StrokeColor draws an outline around the marker when set
+	StrokeColor Color
+	// Regression draws a least-squares linear regression line over the series
+	Regression bool
+}
+
+type scatterChart struct {
+	p   *Painter
+	opt *ScatterChartOption
+}
+
+// NewScatterChart returns a scatter chart renderer
+func NewScatterChart(p *Painter, opt ScatterChartOption) *scatterChart {
+	if opt.Theme == nil {
+		opt.Theme = defaultTheme
+	}
+	return &scatterChart{
+		p:   p,
+		opt: &opt,
+	}
+}
+
+type ScatterChartOption struct {
+	// The theme
+	Theme ColorPalette
+	// The font
+	Font *truetype.Font
+	// The data series list
+	SeriesList SeriesList
+	// The x axis option
+	XAxis XAxisOption
+	// The padding of scatter chart
+	Padding Box
+	// The y axis option
+	YAxisOptions []YAxisOption
+	// The option of title
+	Title TitleOption
+	// The legend option
+	Legend LegendOption
+	// SeriesStyles, index-aligned with SeriesList, controls per-series marker appearance
+	SeriesStyles []ScatterSeriesStyle
+}
+
+func (s *ScatterChartOption) styleFor(index int) ScatterSeriesStyle {
+	if index < len(s.SeriesStyles) {
+		style := s.SeriesStyles[index]
+		if style.Shape == "" {
+			style.Shape = MarkerShapeCircle
+		}
+		if style.Size == 0 {
+			style.Size = 3
+		}
+		if style.Opacity == 0 {
+			style.Opacity = 1
+		}
+		return style
+	}
+	return ScatterSeriesStyle{
+		Shape:   MarkerShapeCircle,
+		Size:    3,
+		Opacity: 1,
+	}
+}
+
+// drawMarker draws a single scatter point using the requested shape. Square
+// and circle fill via their native primitives; triangle fills its outline
+// via FillArea so it matches them instead of rendering hollow the way
+// Polygon (stroke-only) would leave it. Cross has no interior to fill, so
+// it's drawn as two strokes in the marker's fill color instead.
+func drawMarker(painter *Painter, shape MarkerShape, center Point, radius float64, style Style) {
+	p := painter.OverrideDrawingStyle(style)
+	switch shape {
+	case MarkerShapeSquare:
+		r := int(radius)
+		p.Rect(chart.Box{
+			Left:   center.X - r,
+			Top:    center.Y - r,
+			Right:  center.X + r,
+			Bottom: center.Y + r,
+		})
+	case MarkerShapeTriangle:
+		p.FillArea(trianglePoints(center, radius))
+	case MarkerShapeCross:
+		crossStyle := LineStyle{
+			StrokeColor: style.FillColor,
+			StrokeWidth: radius / 2,
+		}
+		p.OverrideDrawingStyle(crossStyle.Style()).LineStroke([]Point{
+			{X: center.X - int(radius), Y: center.Y},
+			{X: center.X + int(radius), Y: center.Y},
+		}, crossStyle)
+		p.OverrideDrawingStyle(crossStyle.Style()).LineStroke([]Point{
+			{X: center.X, Y: center.Y - int(radius)},
+			{X: center.X, Y: center.Y + int(radius)},
+		}, crossStyle)
+	default:
+		p.Circle(radius, center.X, center.Y)
+	}
+}
+
+// trianglePoints returns the three vertices of an upward-pointing triangle
+// inscribed in a circle of the given radius around center.
+func trianglePoints(center Point, radius float64) []Point {
+	angles := [3]float64{-math.Pi / 2, math.Pi/2 + math.Pi/3, math.Pi/2 - math.Pi/3}
+	points := make([]Point, 3)
+	for i, angle := range angles {
+		points[i] = Point{
+			X: center.X + int(radius*math.Cos(angle)),
+			Y: center.Y + int(radius*math.Sin(angle)),
+		}
+	}
+	return points
+}
+
+// linearRegression computes the slope and intercept of the least-squares
+// line through the given points.
+func linearRegression(points []Point) (slope, intercept float64) {
+	n := float64(len(points))
+	if n == 0 {
+		return 0, 0
+	}
+	var sumX, sumY, sumXY, sumXX float64
+	for _, pt := range points {
+		x := float64(pt.X)
+		y := float64(pt.Y)
+		sumX += x
+		sumY += y
+		sumXY += x * y
+		sumXX += x * x
+	}
+	denom := n*sumXX - sumX*sumX
+	if denom == 0 {
+		return 0, sumY / n
+	}
+	slope = (n*sumXY - sumX*sumY) / denom
+	intercept = (sumY - slope*sumX) / n
+	return slope, intercept
+}
+
+func (s *scatterChart) render(result *defaultRenderResult, seriesList SeriesList) (Box, error) {
+	p := s.p
+	opt := s.opt
+	seriesPainter := result.seriesPainter
+
+	numeric := len(opt.XAxis.Data) == 0 && len(opt.XAxis.Values) > 0
+	var xRange *Range
+	if numeric {
+		min, max := opt.XAxis.Values[0], opt.XAxis.Values[0]
+		for _, v := range opt.XAxis.Values {
+			if v < min {
+				min = v
+			}
+			if v > max {
+				max = v
+			}
+		}
+		xRange = NewRange(AxisRangeOption{
+			Min:         min,
+			Max:         max,
+			Size:        seriesPainter.Width(),
+			DivideCount: defaultValueAxisDivideCount,
+		})
+	} else {
+		xRange = NewRange(AxisRangeOption{
+			DivideCount: len(opt.XAxis.Data),
+			Size:        seriesPainter.Width(),
+		})
+	}
+	plotHeight := seriesPainter.Height()
+	theme := opt.Theme
+	divideValues := xRange.AutoDivide()
+
+	markPointPainter := NewMarkPointPainter(seriesPainter)
+	markLinePainter := NewMarkLinePainter(seriesPainter)
+	rendererList := []Renderer{
+		markPointPainter,
+		markLinePainter,
+	}
+
+	for index := range seriesList {
+		series := seriesList[index]
+		yRange := result.axisRanges[series.AxisIndex]
+		seriesColor := theme.GetSeriesColor(series.index)
+		style := opt.styleFor(index)
+		strokeColor := style.StrokeColor
+		strokeWidth := 0.0
+		if strokeColor.IsZero() {
+			strokeColor = seriesColor
+		} else {
+			strokeWidth = 1
+		}
+
+		points := make([]Point, 0, len(series.Data))
+		for j, item := range series.Data {
+			var x int
+			if numeric {
+				x = int(xRange.getHeight(opt.XAxis.Values[j]))
+			} else {
+				if j >= xRange.divideCount {
+					continue
+				}
+				x = divideValues[j]
+			}
+			y := plotHeight - int(yRange.getHeight(item.Value))
+			center := Point{X: x, Y: y}
+			points = append(points, center)
+
+			radius := style.Size
+			if item.Bubble != 0 {
+				radius = item.Bubble
+			}
+			drawMarker(seriesPainter, style.Shape, center, radius, Style{
+				FillColor:   seriesColor.WithAlpha(uint8(style.Opacity * 255)),
+				StrokeColor: strokeColor,
+				StrokeWidth: strokeWidth,
+			})
+		}
+
+		if style.Regression && len(points) > 1 {
+			slope, intercept := linearRegression(points)
+			// seriesPainter's own local, 0-based coordinate space, same as
+			// every plotted point above, so the line lines up with them. The
+			// line spans the full plot width; it is not clipped at the top or
+			// bottom edge of the plot box.
+			width := seriesPainter.Width()
+			lineStyle := LineStyle{
+				StrokeColor: seriesColor,
+				StrokeWidth: 1,
+			}
+			seriesPainter.OverrideDrawingStyle(lineStyle.Style()).LineStroke([]Point{
+				{X: 0, Y: int(intercept)},
+				{X: width, Y: int(slope*float64(width) + intercept)},
+			}, lineStyle)
+		}
+
+		markPointPainter.Add(markPointRenderOption{
+			FillColor: seriesColor,
+			Font:      opt.Font,
+			Series:    series,
+			Points:    points,
+		})
+		markLinePainter.Add(markLineRenderOption{
+			FillColor:   seriesColor,
+			FontColor:   theme.GetTextColor(),
+			StrokeColor: seriesColor,
+			Font:        opt.Font,
+			Series:      series,
+			Range:       yRange,
+		})
+	}
+
+	if err := doRender(rendererList...); err != nil {
+		return BoxZero, err
+	}
+
+	return p.box, nil
+}
+
+func (s *scatterChart) Render() (Box, error) {
+	p := s.p
+	opt := s.opt
+	renderResult, err := defaultRender(p, defaultRenderOption{
+		Theme:        opt.Theme,
+		Padding:      opt.Padding,
+		SeriesList:   opt.SeriesList,
+		XAxis:        opt.XAxis,
+		YAxisOptions: opt.YAxisOptions,
+		TitleOption:  opt.Title,
+		LegendOption: opt.Legend,
+	})
+	if err != nil {
+		return BoxZero, err
+	}
+	seriesList := opt.SeriesList.Filter(ChartTypeScatter)
+	return s.render(renderResult, seriesList)
+}
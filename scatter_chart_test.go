@@ -0,0 +1,86 @@
+// MIT License
+
+// Copyright (c) 2022 Tree Xie
+
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+
+// The above copyright notice and this permission notice shall be included in all
+// copies or substantial portions of the Software.
+
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE
+// SOFTWARE.
+
+package charts
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLinearRegressionExactLine(t *testing.T) {
+	// y = 2x + 1, noise-free, so the fit should recover the coefficients exactly.
+	points := []Point{
+		{X: 0, Y: 1},
+		{X: 1, Y: 3},
+		{X: 2, Y: 5},
+		{X: 3, Y: 7},
+	}
+	slope, intercept := linearRegression(points)
+	if math.Abs(slope-2) > 1e-9 {
+		t.Errorf("slope = %v, want 2", slope)
+	}
+	if math.Abs(intercept-1) > 1e-9 {
+		t.Errorf("intercept = %v, want 1", intercept)
+	}
+}
+
+func TestLinearRegressionEmpty(t *testing.T) {
+	slope, intercept := linearRegression(nil)
+	if slope != 0 || intercept != 0 {
+		t.Errorf("linearRegression(nil) = (%v, %v), want (0, 0)", slope, intercept)
+	}
+}
+
+func TestLinearRegressionVerticalSpread(t *testing.T) {
+	// Every point shares the same x, so the least-squares denominator is 0
+	// and the fit degenerates to a flat line through the mean y.
+	points := []Point{
+		{X: 5, Y: 10},
+		{X: 5, Y: 20},
+		{X: 5, Y: 30},
+	}
+	slope, intercept := linearRegression(points)
+	if slope != 0 {
+		t.Errorf("slope = %v, want 0", slope)
+	}
+	if math.Abs(intercept-20) > 1e-9 {
+		t.Errorf("intercept = %v, want 20", intercept)
+	}
+}
+
+func TestTrianglePointsInscribedInCircle(t *testing.T) {
+	center := Point{X: 100, Y: 100}
+	radius := 10.0
+	points := trianglePoints(center, radius)
+	if len(points) != 3 {
+		t.Fatalf("len(points) = %d, want 3", len(points))
+	}
+	for _, p := range points {
+		dx := float64(p.X - center.X)
+		dy := float64(p.Y - center.Y)
+		dist := math.Hypot(dx, dy)
+		if math.Abs(dist-radius) > 1 {
+			t.Errorf("point %+v is %.2f from center, want ~%.2f", p, dist, radius)
+		}
+	}
+}